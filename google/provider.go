@@ -0,0 +1,52 @@
+package google
+
+import (
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+// Provider returns a terraform.ResourceProvider.
+func Provider() terraform.ResourceProvider {
+	provider := &schema.Provider{
+		Schema: map[string]*schema.Schema{
+			"credentials": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"project": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"region": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+
+		ResourcesMap: map[string]*schema.Resource{
+			"google_compute_forwarding_rule":   resourceComputeForwardingRule(),
+			"google_compute_http_health_check": resourceComputeHttpHealthCheck(),
+			"google_compute_target_pool":       resourceComputeTargetPool(),
+		},
+
+		ConfigureFunc: providerConfigure,
+	}
+
+	return provider
+}
+
+func providerConfigure(d *schema.ResourceData) (interface{}, error) {
+	config := &Config{
+		Credentials: d.Get("credentials").(string),
+		Project:     d.Get("project").(string),
+		Region:      d.Get("region").(string),
+	}
+
+	if err := config.loadAndValidate(); err != nil {
+		return nil, err
+	}
+
+	return config, nil
+}
@@ -48,7 +48,6 @@ func resourceComputeForwardingRule() *schema.Resource {
 			"ip_address": &schema.Schema{
 				Type:     schema.TypeString,
 				Optional: true,
-				ForceNew: true,
 				Computed: true,
 			},
 
@@ -60,6 +59,17 @@ func resourceComputeForwardingRule() *schema.Resource {
 				DiffSuppressFunc: caseDiffSuppress,
 			},
 
+			"labels": &schema.Schema{
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"label_fingerprint": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
 			"load_balancing_scheme": &schema.Schema{
 				Type:     schema.TypeString,
 				Optional: true,
@@ -86,7 +96,6 @@ func resourceComputeForwardingRule() *schema.Resource {
 			"port_range": &schema.Schema{
 				Type:             schema.TypeString,
 				Optional:         true,
-				ForceNew:         true,
 				DiffSuppressFunc: portRangeDiffSuppress,
 			},
 
@@ -94,7 +103,6 @@ func resourceComputeForwardingRule() *schema.Resource {
 				Type:     schema.TypeSet,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 				Optional: true,
-				ForceNew: true,
 				Set:      schema.HashString,
 				MaxItems: 5,
 			},
@@ -158,12 +166,18 @@ func resourceComputeForwardingRuleCreate(d *schema.ResourceData, meta interface{
 		ports = append(ports, v.(string))
 	}
 
+	scheme := d.Get("load_balancing_scheme").(string)
+	if err := validateForwardingRulePortsScheme(scheme, ports); err != nil {
+		return err
+	}
+
 	frule := &computeBeta.ForwardingRule{
 		BackendService:      d.Get("backend_service").(string),
 		IPAddress:           d.Get("ip_address").(string),
 		IPProtocol:          d.Get("ip_protocol").(string),
 		Description:         d.Get("description").(string),
 		LoadBalancingScheme: d.Get("load_balancing_scheme").(string),
+		Labels:              expandLabels(d),
 		Name:                d.Get("name").(string),
 		Network:             network.RelativeLink(),
 		NetworkTier:         d.Get("network_tier").(string),
@@ -223,11 +237,161 @@ func resourceComputeForwardingRuleUpdate(d *schema.ResourceData, meta interface{
 		d.SetPartial("target")
 	}
 
+	if d.HasChange("ip_address") || d.HasChange("port_range") || d.HasChange("ports") {
+		ps := d.Get("ports").(*schema.Set).List()
+		ports := make([]string, 0, len(ps))
+		for _, v := range ps {
+			ports = append(ports, v.(string))
+		}
+
+		scheme := d.Get("load_balancing_scheme").(string)
+		if err := validateForwardingRulePortsScheme(scheme, ports); err != nil {
+			return err
+		}
+
+		if scheme == "INTERNAL" {
+			frule := &computeBeta.ForwardingRule{
+				IPAddress: d.Get("ip_address").(string),
+				PortRange: d.Get("port_range").(string),
+				Ports:     ports,
+			}
+
+			log.Printf("[DEBUG] ForwardingRule patch request: %#v", frule)
+			op, err := config.clientComputeBeta.ForwardingRules.Patch(
+				project, region, d.Id(), frule).Do()
+			if err != nil {
+				return fmt.Errorf("Error updating ForwardingRule: %s", err)
+			}
+
+			err = computeSharedOperationWait(config.clientCompute, op, project, "Updating Forwarding Rule")
+			if err != nil {
+				return err
+			}
+		} else {
+			// EXTERNAL forwarding rules don't support Patch for these fields, so
+			// retain the existing (or newly requested) IP reservation and recreate
+			// the rule around it instead of losing it to a ForceNew destroy/create.
+			if err := resourceComputeForwardingRuleRecreate(d, meta, region, project); err != nil {
+				return err
+			}
+		}
+
+		d.SetPartial("ip_address")
+		d.SetPartial("port_range")
+		d.SetPartial("ports")
+	}
+
+	if d.HasChange("labels") {
+		frule, err := config.clientComputeBeta.ForwardingRules.Get(
+			project, region, d.Id()).Do()
+		if err != nil {
+			return fmt.Errorf("Error retrieving ForwardingRule %q: %s", d.Id(), err)
+		}
+
+		labelsReq := computeBeta.RegionSetLabelsRequest{
+			Labels:           expandLabels(d),
+			LabelFingerprint: frule.LabelFingerprint,
+		}
+		op, err := config.clientComputeBeta.ForwardingRules.SetLabels(
+			project, region, d.Id(), &labelsReq).Do()
+		if err != nil {
+			return fmt.Errorf("Error updating labels: %s", err)
+		}
+
+		err = computeSharedOperationWait(config.clientCompute, op, project, "Updating Forwarding Rule Labels")
+		if err != nil {
+			return err
+		}
+
+		d.SetPartial("labels")
+	}
+
 	d.Partial(false)
 
 	return resourceComputeForwardingRuleRead(d, meta)
 }
 
+// resourceComputeForwardingRuleRecreate deletes and re-inserts an EXTERNAL
+// forwarding rule, re-inserting it with the configured ip_address (the current
+// one, or the newly requested one when it changed) so the frontend IP carries
+// over to the replacement.
+func resourceComputeForwardingRuleRecreate(d *schema.ResourceData, meta interface{}, region, project string) error {
+	config := meta.(*Config)
+
+	log.Printf("[DEBUG] ForwardingRule delete request (recreate for update)")
+	op, err := config.clientCompute.ForwardingRules.Delete(
+		project, region, d.Id()).Do()
+	if err != nil {
+		return fmt.Errorf("Error deleting ForwardingRule: %s", err)
+	}
+
+	err = computeOperationWait(config.clientCompute, op, project, "Deleting Forwarding Rule")
+	if err != nil {
+		return err
+	}
+
+	// The rule is gone remotely from this point on: if anything below fails,
+	// clear the ID instead of leaving stale attributes in state describing a
+	// resource that no longer exists.
+	d.SetId("")
+
+	network, err := ParseNetworkFieldValue(d.Get("network").(string), d, config)
+	if err != nil {
+		return err
+	}
+
+	subnetwork, err := ParseSubnetworkFieldValue(d.Get("subnetwork").(string), d, config)
+	if err != nil {
+		return err
+	}
+
+	ps := d.Get("ports").(*schema.Set).List()
+	ports := make([]string, 0, len(ps))
+	for _, v := range ps {
+		ports = append(ports, v.(string))
+	}
+
+	frule := &computeBeta.ForwardingRule{
+		BackendService:      d.Get("backend_service").(string),
+		IPAddress:           d.Get("ip_address").(string),
+		IPProtocol:          d.Get("ip_protocol").(string),
+		Description:         d.Get("description").(string),
+		LoadBalancingScheme: d.Get("load_balancing_scheme").(string),
+		Labels:              expandLabels(d),
+		Name:                d.Get("name").(string),
+		Network:             network.RelativeLink(),
+		NetworkTier:         d.Get("network_tier").(string),
+		PortRange:           d.Get("port_range").(string),
+		Ports:               ports,
+		Subnetwork:          subnetwork.RelativeLink(),
+		Target:              ConvertSelfLinkToV1(d.Get("target").(string)),
+	}
+
+	log.Printf("[DEBUG] ForwardingRule insert request (recreate for update): %#v", frule)
+	op, err = config.clientComputeBeta.ForwardingRules.Insert(
+		project, region, frule).Do()
+	if err != nil {
+		return fmt.Errorf("Error creating ForwardingRule: %s", err)
+	}
+
+	err = computeSharedOperationWait(config.clientCompute, op, project, "Creating Fowarding Rule")
+	if err != nil {
+		return err
+	}
+
+	d.SetId(frule.Name)
+	return nil
+}
+
+// validateForwardingRulePortsScheme rejects field combinations the API itself
+// would reject: `ports` is only valid for INTERNAL forwarding rules.
+func validateForwardingRulePortsScheme(scheme string, ports []string) error {
+	if scheme == "EXTERNAL" && len(ports) > 0 {
+		return fmt.Errorf("`ports` is not supported for EXTERNAL forwarding rules; use `port_range` instead")
+	}
+	return nil
+}
+
 func resourceComputeForwardingRuleRead(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*Config)
 
@@ -252,6 +416,8 @@ func resourceComputeForwardingRuleRead(d *schema.ResourceData, meta interface{})
 	d.Set("backend_service", ConvertSelfLinkToV1(frule.BackendService))
 	d.Set("description", frule.Description)
 	d.Set("load_balancing_scheme", frule.LoadBalancingScheme)
+	d.Set("labels", frule.Labels)
+	d.Set("label_fingerprint", frule.LabelFingerprint)
 	d.Set("network", frule.Network)
 	d.Set("network_tier", frule.NetworkTier)
 	d.Set("port_range", frule.PortRange)
@@ -0,0 +1,183 @@
+package google
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	computeBeta "google.golang.org/api/compute/v0.beta"
+)
+
+func TestAccComputeForwardingRule_internalUpdate(t *testing.T) {
+	t.Parallel()
+
+	var frule computeBeta.ForwardingRule
+	ruleName := fmt.Sprintf("tf-test-frule-%s", acctest.RandString(10))
+	netName := fmt.Sprintf("tf-test-net-%s", acctest.RandString(10))
+	subnetName := fmt.Sprintf("tf-test-subnet-%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeForwardingRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComputeForwardingRule_internal(netName, subnetName, ruleName, "10.0.0.10", "80"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeForwardingRuleExists(
+						"google_compute_forwarding_rule.foobar", &frule),
+					resource.TestCheckResourceAttr(
+						"google_compute_forwarding_rule.foobar", "ip_address", "10.0.0.10"),
+					resource.TestCheckResourceAttr(
+						"google_compute_forwarding_rule.foobar", "ports.#", "1"),
+				),
+			},
+			{
+				// Same INTERNAL load_balancing_scheme, new ip_address/ports -
+				// should be patched in place rather than destroying and
+				// recreating the forwarding rule.
+				Config: testAccComputeForwardingRule_internal(netName, subnetName, ruleName, "10.0.0.11", "8080"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeForwardingRuleExists(
+						"google_compute_forwarding_rule.foobar", &frule),
+					resource.TestCheckResourceAttr(
+						"google_compute_forwarding_rule.foobar", "ip_address", "10.0.0.11"),
+					resource.TestCheckResourceAttr(
+						"google_compute_forwarding_rule.foobar", "ports.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccComputeForwardingRule_labels(t *testing.T) {
+	t.Parallel()
+
+	var frule computeBeta.ForwardingRule
+	ruleName := fmt.Sprintf("tf-test-frule-%s", acctest.RandString(10))
+	poolName := fmt.Sprintf("tf-test-tpool-%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeForwardingRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComputeForwardingRule_labels(poolName, ruleName, "env", "test"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeForwardingRuleExists(
+						"google_compute_forwarding_rule.foobar", &frule),
+					resource.TestCheckResourceAttr(
+						"google_compute_forwarding_rule.foobar", "labels.env", "test"),
+					resource.TestCheckResourceAttrSet(
+						"google_compute_forwarding_rule.foobar", "label_fingerprint"),
+				),
+			},
+			{
+				// Changing the labels should go through SetLabels using the
+				// fingerprint fetched from the API at update time, not a
+				// destroy/create.
+				Config: testAccComputeForwardingRule_labels(poolName, ruleName, "env", "prod"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeForwardingRuleExists(
+						"google_compute_forwarding_rule.foobar", &frule),
+					resource.TestCheckResourceAttr(
+						"google_compute_forwarding_rule.foobar", "labels.env", "prod"),
+					resource.TestCheckResourceAttrSet(
+						"google_compute_forwarding_rule.foobar", "label_fingerprint"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckComputeForwardingRuleDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "google_compute_forwarding_rule" {
+			continue
+		}
+
+		_, err := config.clientComputeBeta.ForwardingRules.Get(
+			config.Project, config.Region, rs.Primary.ID).Do()
+		if err == nil {
+			return fmt.Errorf("ForwardingRule still exists")
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckComputeForwardingRuleExists(n string, frule *computeBeta.ForwardingRule) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+
+		found, err := config.clientComputeBeta.ForwardingRules.Get(
+			config.Project, config.Region, rs.Primary.ID).Do()
+		if err != nil {
+			return err
+		}
+
+		if found.Name != rs.Primary.ID {
+			return fmt.Errorf("ForwardingRule not found")
+		}
+
+		*frule = *found
+
+		return nil
+	}
+}
+
+func testAccComputeForwardingRule_internal(netName, subnetName, ruleName, ipAddress, port string) string {
+	return fmt.Sprintf(`
+resource "google_compute_network" "foobar" {
+  name                    = "%s"
+  auto_create_subnetworks = false
+}
+
+resource "google_compute_subnetwork" "foobar" {
+  name          = "%s"
+  network       = "${google_compute_network.foobar.self_link}"
+  ip_cidr_range = "10.0.0.0/24"
+}
+
+resource "google_compute_forwarding_rule" "foobar" {
+  name                  = "%s"
+  load_balancing_scheme = "INTERNAL"
+  network               = "${google_compute_network.foobar.self_link}"
+  subnetwork            = "${google_compute_subnetwork.foobar.self_link}"
+  ip_address            = "%s"
+  ports                 = ["%s"]
+}
+`, netName, subnetName, ruleName, ipAddress, port)
+}
+
+func testAccComputeForwardingRule_labels(poolName, ruleName, labelKey, labelValue string) string {
+	return fmt.Sprintf(`
+resource "google_compute_target_pool" "foobar" {
+  name = "%s"
+}
+
+resource "google_compute_forwarding_rule" "foobar" {
+  name       = "%s"
+  target     = "${google_compute_target_pool.foobar.self_link}"
+  port_range = "80"
+
+  labels = {
+    %s = "%s"
+  }
+}
+`, poolName, ruleName, labelKey, labelValue)
+}
@@ -0,0 +1,213 @@
+package google
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"google.golang.org/api/compute/v1"
+)
+
+func resourceComputeHttpHealthCheck() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceComputeHttpHealthCheckCreate,
+		Read:   resourceComputeHttpHealthCheckRead,
+		Delete: resourceComputeHttpHealthCheckDelete,
+		Update: resourceComputeHttpHealthCheckUpdate,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"check_interval_sec": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  5,
+			},
+
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"healthy_threshold": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  2,
+			},
+
+			"host": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"port": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  80,
+			},
+
+			"request_path": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "/",
+			},
+
+			"project": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Computed: true,
+			},
+
+			"self_link": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"timeout_sec": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  5,
+			},
+
+			"unhealthy_threshold": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  2,
+			},
+		},
+	}
+}
+
+func resourceComputeHttpHealthCheckCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	hchk := &compute.HttpHealthCheck{
+		Name:               d.Get("name").(string),
+		CheckIntervalSec:   int64(d.Get("check_interval_sec").(int)),
+		HealthyThreshold:   int64(d.Get("healthy_threshold").(int)),
+		Host:               d.Get("host").(string),
+		Port:               int64(d.Get("port").(int)),
+		RequestPath:        d.Get("request_path").(string),
+		TimeoutSec:         int64(d.Get("timeout_sec").(int)),
+		UnhealthyThreshold: int64(d.Get("unhealthy_threshold").(int)),
+		Description:        d.Get("description").(string),
+	}
+
+	log.Printf("[DEBUG] HttpHealthCheck insert request: %#v", hchk)
+	op, err := config.clientCompute.HttpHealthChecks.Insert(
+		project, hchk).Do()
+	if err != nil {
+		return fmt.Errorf("Error creating HttpHealthCheck: %s", err)
+	}
+
+	// It probably maybe worked, so store the ID now
+	d.SetId(hchk.Name)
+
+	err = computeOperationWait(config.clientCompute, op, project, "Creating Http Health Check")
+	if err != nil {
+		return err
+	}
+
+	return resourceComputeHttpHealthCheckRead(d, meta)
+}
+
+func resourceComputeHttpHealthCheckUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	hchk := &compute.HttpHealthCheck{
+		Name:               d.Get("name").(string),
+		CheckIntervalSec:   int64(d.Get("check_interval_sec").(int)),
+		HealthyThreshold:   int64(d.Get("healthy_threshold").(int)),
+		Host:               d.Get("host").(string),
+		Port:               int64(d.Get("port").(int)),
+		RequestPath:        d.Get("request_path").(string),
+		TimeoutSec:         int64(d.Get("timeout_sec").(int)),
+		UnhealthyThreshold: int64(d.Get("unhealthy_threshold").(int)),
+		Description:        d.Get("description").(string),
+	}
+
+	log.Printf("[DEBUG] HttpHealthCheck update request: %#v", hchk)
+	op, err := config.clientCompute.HttpHealthChecks.Update(
+		project, d.Id(), hchk).Do()
+	if err != nil {
+		return fmt.Errorf("Error updating HttpHealthCheck: %s", err)
+	}
+
+	err = computeOperationWait(config.clientCompute, op, project, "Updating Http Health Check")
+	if err != nil {
+		return err
+	}
+
+	return resourceComputeHttpHealthCheckRead(d, meta)
+}
+
+func resourceComputeHttpHealthCheckRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	hchk, err := config.clientCompute.HttpHealthChecks.Get(
+		project, d.Id()).Do()
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("HttpHealthCheck %q", d.Get("name").(string)))
+	}
+
+	d.Set("name", hchk.Name)
+	d.Set("check_interval_sec", hchk.CheckIntervalSec)
+	d.Set("healthy_threshold", hchk.HealthyThreshold)
+	d.Set("host", hchk.Host)
+	d.Set("port", hchk.Port)
+	d.Set("request_path", hchk.RequestPath)
+	d.Set("timeout_sec", hchk.TimeoutSec)
+	d.Set("unhealthy_threshold", hchk.UnhealthyThreshold)
+	d.Set("description", hchk.Description)
+	d.Set("project", project)
+	d.Set("self_link", hchk.SelfLink)
+	return nil
+}
+
+func resourceComputeHttpHealthCheckDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	// Delete the HttpHealthCheck
+	log.Printf("[DEBUG] HttpHealthCheck delete request")
+	op, err := config.clientCompute.HttpHealthChecks.Delete(
+		project, d.Id()).Do()
+	if err != nil {
+		return fmt.Errorf("Error deleting HttpHealthCheck: %s", err)
+	}
+
+	err = computeOperationWait(config.clientCompute, op, project, "Deleting Http Health Check")
+	if err != nil {
+		return err
+	}
+
+	d.SetId("")
+	return nil
+}
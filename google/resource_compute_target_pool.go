@@ -0,0 +1,326 @@
+package google
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"google.golang.org/api/compute/v1"
+)
+
+func resourceComputeTargetPool() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceComputeTargetPoolCreate,
+		Read:   resourceComputeTargetPoolRead,
+		Delete: resourceComputeTargetPoolDelete,
+		Update: resourceComputeTargetPoolUpdate,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"backup_pool": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+
+			"failover_ratio": &schema.Schema{
+				Type:     schema.TypeFloat,
+				Optional: true,
+				ForceNew: true,
+			},
+
+			"health_checks": &schema.Schema{
+				Type:     schema.TypeList,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Optional: true,
+				MaxItems: 1,
+			},
+
+			"instances": &schema.Schema{
+				Type:     schema.TypeSet,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Optional: true,
+				Set:      schema.HashString,
+			},
+
+			"project": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Computed: true,
+			},
+
+			"region": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Computed: true,
+			},
+
+			"self_link": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"session_affinity": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceComputeTargetPoolCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	region, err := getRegion(d, config)
+	if err != nil {
+		return err
+	}
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	hchkUrls := expandStringList(d.Get("health_checks").([]interface{}))
+
+	instances := expandStringList(d.Get("instances").(*schema.Set).List())
+
+	tpool := &compute.TargetPool{
+		BackupPool:      d.Get("backup_pool").(string),
+		Description:     d.Get("description").(string),
+		FailoverRatio:   d.Get("failover_ratio").(float64),
+		HealthChecks:    hchkUrls,
+		Instances:       instances,
+		Name:            d.Get("name").(string),
+		SessionAffinity: d.Get("session_affinity").(string),
+	}
+
+	log.Printf("[DEBUG] TargetPool insert request: %#v", tpool)
+	op, err := config.clientCompute.TargetPools.Insert(
+		project, region, tpool).Do()
+	if err != nil {
+		return fmt.Errorf("Error creating TargetPool: %s", err)
+	}
+
+	// It probably maybe worked, so store the ID now
+	d.SetId(tpool.Name)
+
+	err = computeOperationWait(config.clientCompute, op, project, "Creating Target Pool")
+	if err != nil {
+		return err
+	}
+
+	return resourceComputeTargetPoolRead(d, meta)
+}
+
+func resourceComputeTargetPoolUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	region, err := getRegion(d, config)
+	if err != nil {
+		return err
+	}
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	d.Partial(true)
+
+	if d.HasChange("health_checks") {
+		from_, to_ := d.GetChange("health_checks")
+		fromUrls := expandStringList(from_.([]interface{}))
+		toUrls := expandStringList(to_.([]interface{}))
+
+		// Add the new health checks before removing the old ones so the pool
+		// is never left without a health check attached in between - a target
+		// pool with none routes traffic to every instance indiscriminately.
+		if len(toUrls) > 0 {
+			addReq := &compute.TargetPoolsAddHealthCheckRequest{
+				HealthChecks: makeHealthCheckReferences(toUrls),
+			}
+			op, err := config.clientCompute.TargetPools.AddHealthCheck(
+				project, region, d.Id(), addReq).Do()
+			if err != nil {
+				return fmt.Errorf("Error adding new health_checks: %s", err)
+			}
+			err = computeOperationWait(config.clientCompute, op, project, "Updating Target Pool Health Check")
+			if err != nil {
+				return err
+			}
+		}
+
+		if len(fromUrls) > 0 {
+			removeReq := &compute.TargetPoolsRemoveHealthCheckRequest{
+				HealthChecks: makeHealthCheckReferences(fromUrls),
+			}
+			op, err := config.clientCompute.TargetPools.RemoveHealthCheck(
+				project, region, d.Id(), removeReq).Do()
+			if err != nil {
+				return fmt.Errorf("Error removing old health_checks after adding new ones: %s", err)
+			}
+			err = computeOperationWait(config.clientCompute, op, project, "Updating Target Pool Health Check")
+			if err != nil {
+				return err
+			}
+		}
+
+		d.SetPartial("health_checks")
+	}
+
+	if d.HasChange("instances") {
+		from_, to_ := d.GetChange("instances")
+		fromSet := from_.(*schema.Set)
+		toSet := to_.(*schema.Set)
+
+		remove := expandStringList(fromSet.Difference(toSet).List())
+		add := expandStringList(toSet.Difference(fromSet).List())
+
+		if len(remove) > 0 {
+			removeReq := &compute.TargetPoolsRemoveInstanceRequest{
+				Instances: makeInstanceReferences(remove),
+			}
+			op, err := config.clientCompute.TargetPools.RemoveInstance(
+				project, region, d.Id(), removeReq).Do()
+			if err != nil {
+				return fmt.Errorf("Error updating instances: %s", err)
+			}
+			err = computeOperationWait(config.clientCompute, op, project, "Updating Target Pool Instances")
+			if err != nil {
+				return err
+			}
+		}
+
+		if len(add) > 0 {
+			addReq := &compute.TargetPoolsAddInstanceRequest{
+				Instances: makeInstanceReferences(add),
+			}
+			op, err := config.clientCompute.TargetPools.AddInstance(
+				project, region, d.Id(), addReq).Do()
+			if err != nil {
+				return fmt.Errorf("Error updating instances: %s", err)
+			}
+			err = computeOperationWait(config.clientCompute, op, project, "Updating Target Pool Instances")
+			if err != nil {
+				return err
+			}
+		}
+
+		d.SetPartial("instances")
+	}
+
+	if d.HasChange("backup_pool") {
+		bpoolName := d.Get("backup_pool").(string)
+		targetRef := &compute.TargetReference{Target: bpoolName}
+		op, err := config.clientCompute.TargetPools.SetBackup(
+			project, region, d.Id(), targetRef).Do()
+		if err != nil {
+			return fmt.Errorf("Error updating backup_pool: %s", err)
+		}
+		err = computeOperationWait(config.clientCompute, op, project, "Updating Target Pool Backup Pool")
+		if err != nil {
+			return err
+		}
+
+		d.SetPartial("backup_pool")
+	}
+
+	d.Partial(false)
+
+	return resourceComputeTargetPoolRead(d, meta)
+}
+
+func resourceComputeTargetPoolRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	region, err := getRegion(d, config)
+	if err != nil {
+		return err
+	}
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	tpool, err := config.clientCompute.TargetPools.Get(
+		project, region, d.Id()).Do()
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("Target Pool %q", d.Get("name").(string)))
+	}
+
+	d.Set("name", tpool.Name)
+	d.Set("backup_pool", tpool.BackupPool)
+	d.Set("description", tpool.Description)
+	d.Set("failover_ratio", tpool.FailoverRatio)
+	d.Set("health_checks", tpool.HealthChecks)
+	d.Set("instances", tpool.Instances)
+	d.Set("project", project)
+	d.Set("region", region)
+	d.Set("session_affinity", tpool.SessionAffinity)
+	d.Set("self_link", tpool.SelfLink)
+	return nil
+}
+
+func resourceComputeTargetPoolDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	region, err := getRegion(d, config)
+	if err != nil {
+		return err
+	}
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	// Delete the TargetPool
+	log.Printf("[DEBUG] TargetPool delete request")
+	op, err := config.clientCompute.TargetPools.Delete(
+		project, region, d.Id()).Do()
+	if err != nil {
+		return fmt.Errorf("Error deleting TargetPool: %s", err)
+	}
+
+	err = computeOperationWait(config.clientCompute, op, project, "Deleting Target Pool")
+	if err != nil {
+		return err
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func makeHealthCheckReferences(urls []string) []*compute.HealthCheckReference {
+	refs := make([]*compute.HealthCheckReference, len(urls))
+	for i, url := range urls {
+		refs[i] = &compute.HealthCheckReference{HealthCheck: url}
+	}
+	return refs
+}
+
+func makeInstanceReferences(urls []string) []*compute.InstanceReference {
+	refs := make([]*compute.InstanceReference, len(urls))
+	for i, url := range urls {
+		refs[i] = &compute.InstanceReference{Instance: url}
+	}
+	return refs
+}
@@ -0,0 +1,171 @@
+package google
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"google.golang.org/api/compute/v1"
+)
+
+func TestAccComputeTargetPool_basic(t *testing.T) {
+	t.Parallel()
+
+	var pool compute.TargetPool
+	poolName := fmt.Sprintf("tf-test-tpool-%s", acctest.RandString(10))
+	hcName := fmt.Sprintf("tf-test-hchk-%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeTargetPoolDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComputeTargetPool_basic(poolName, hcName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeTargetPoolExists(
+						"google_compute_target_pool.foobar", &pool),
+					resource.TestCheckResourceAttr(
+						"google_compute_target_pool.foobar", "health_checks.#", "1"),
+				),
+			},
+			{
+				ResourceName:      "google_compute_target_pool.foobar",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccComputeTargetPool_update(t *testing.T) {
+	t.Parallel()
+
+	var pool compute.TargetPool
+	poolName := fmt.Sprintf("tf-test-tpool-%s", acctest.RandString(10))
+	hcName := fmt.Sprintf("tf-test-hchk-%s", acctest.RandString(10))
+	hcName2 := fmt.Sprintf("tf-test-hchk-%s", acctest.RandString(10))
+	instanceName := fmt.Sprintf("tf-test-inst-%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeTargetPoolDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComputeTargetPool_basic(poolName, hcName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeTargetPoolExists(
+						"google_compute_target_pool.foobar", &pool),
+				),
+			},
+			{
+				// Swaps the health check (Add+Remove) and attaches an instance,
+				// exercising the diffing logic in resourceComputeTargetPoolUpdate.
+				Config: testAccComputeTargetPool_updated(poolName, hcName2, instanceName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeTargetPoolExists(
+						"google_compute_target_pool.foobar", &pool),
+					resource.TestCheckResourceAttr(
+						"google_compute_target_pool.foobar", "health_checks.#", "1"),
+					resource.TestCheckResourceAttr(
+						"google_compute_target_pool.foobar", "instances.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckComputeTargetPoolDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "google_compute_target_pool" {
+			continue
+		}
+
+		_, err := config.clientCompute.TargetPools.Get(
+			config.Project, config.Region, rs.Primary.ID).Do()
+		if err == nil {
+			return fmt.Errorf("TargetPool still exists")
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckComputeTargetPoolExists(n string, pool *compute.TargetPool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+
+		found, err := config.clientCompute.TargetPools.Get(
+			config.Project, config.Region, rs.Primary.ID).Do()
+		if err != nil {
+			return err
+		}
+
+		if found.Name != rs.Primary.ID {
+			return fmt.Errorf("TargetPool not found")
+		}
+
+		*pool = *found
+
+		return nil
+	}
+}
+
+func testAccComputeTargetPool_basic(poolName, hcName string) string {
+	return fmt.Sprintf(`
+resource "google_compute_http_health_check" "foobar" {
+  name         = "%s"
+  request_path = "/health"
+}
+
+resource "google_compute_target_pool" "foobar" {
+  name          = "%s"
+  health_checks = ["${google_compute_http_health_check.foobar.self_link}"]
+}
+`, hcName, poolName)
+}
+
+func testAccComputeTargetPool_updated(poolName, hcName, instanceName string) string {
+	return fmt.Sprintf(`
+resource "google_compute_http_health_check" "foobar" {
+  name         = "%s"
+  request_path = "/health"
+}
+
+resource "google_compute_instance" "foobar" {
+  name         = "%s"
+  machine_type = "n1-standard-1"
+  zone         = "us-central1-a"
+
+  boot_disk {
+    initialize_params {
+      image = "debian-cloud/debian-9"
+    }
+  }
+
+  network_interface {
+    network = "default"
+  }
+}
+
+resource "google_compute_target_pool" "foobar" {
+  name          = "%s"
+  health_checks = ["${google_compute_http_health_check.foobar.self_link}"]
+  instances     = ["${google_compute_instance.foobar.self_link}"]
+}
+`, hcName, instanceName, poolName)
+}